@@ -0,0 +1,123 @@
+package breaker
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// BackoffStrategy computes the delay to wait before the next retry attempt.
+// Implementations are consulted once per failed attempt that will be retried;
+// attempt is the 0-indexed retry count (it restarts at 0 when
+// WithSuccessResetAfter resets the budget), and lastDelay is the delay Breaker
+// last waited, after any WithMaxInterval cap or Retry-After adjustment (zero
+// on the first call and after a reset).
+type BackoffStrategy interface {
+	NextDelay(attempt int, lastDelay time.Duration) time.Duration
+
+	// Reset clears any internal state so the strategy can be reused to
+	// drive a new retry cycle.
+	Reset()
+}
+
+// Exponential waits Base*Factor^attempt between attempts. This is the
+// classic doubling backoff and the strategy Breaker uses by default.
+type Exponential struct {
+	Base   time.Duration
+	Factor float64
+}
+
+func NewExponential(base time.Duration, factor float64) *Exponential {
+	return &Exponential{Base: base, Factor: factor}
+}
+
+func (e *Exponential) NextDelay(attempt int, lastDelay time.Duration) time.Duration {
+	return time.Duration(float64(e.Base) * math.Pow(e.Factor, float64(attempt)))
+}
+
+func (e *Exponential) Reset() {}
+
+// ExponentialWithFullJitter wraps Exponential but returns a delay chosen
+// uniformly at random from [0, delay], which spreads out clients that would
+// otherwise back off in lock-step.
+type ExponentialWithFullJitter struct {
+	Exponential
+}
+
+func NewExponentialWithFullJitter(base time.Duration, factor float64) *ExponentialWithFullJitter {
+	return &ExponentialWithFullJitter{Exponential{Base: base, Factor: factor}}
+}
+
+func (e *ExponentialWithFullJitter) NextDelay(attempt int, lastDelay time.Duration) time.Duration {
+	delay := e.Exponential.NextDelay(attempt, lastDelay)
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// ExponentialWithEqualJitter wraps Exponential but returns delay/2 plus a
+// random value in [0, delay/2], keeping half the backoff deterministic while
+// still avoiding thundering herds.
+type ExponentialWithEqualJitter struct {
+	Exponential
+}
+
+func NewExponentialWithEqualJitter(base time.Duration, factor float64) *ExponentialWithEqualJitter {
+	return &ExponentialWithEqualJitter{Exponential{Base: base, Factor: factor}}
+}
+
+func (e *ExponentialWithEqualJitter) NextDelay(attempt int, lastDelay time.Duration) time.Duration {
+	delay := e.Exponential.NextDelay(attempt, lastDelay)
+	half := delay / 2
+	if half <= 0 {
+		return half
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// DecorrelatedJitter implements the "decorrelated jitter" backoff from the
+// AWS Architecture Blog post on retries with backoff and jitter:
+// sleep = min(Cap, random_between(Base, lastDelay*3)).
+type DecorrelatedJitter struct {
+	Base time.Duration
+	Cap  time.Duration
+}
+
+func NewDecorrelatedJitter(base, cap time.Duration) *DecorrelatedJitter {
+	return &DecorrelatedJitter{Base: base, Cap: cap}
+}
+
+func (d *DecorrelatedJitter) NextDelay(attempt int, lastDelay time.Duration) time.Duration {
+	if lastDelay <= 0 {
+		lastDelay = d.Base
+	}
+
+	upper := int64(lastDelay) * 3
+	if upper <= int64(d.Base) {
+		upper = int64(d.Base) + 1
+	}
+
+	next := d.Base + time.Duration(rand.Int63n(upper-int64(d.Base)))
+	if d.Cap > 0 && next > d.Cap {
+		next = d.Cap
+	}
+	return next
+}
+
+func (d *DecorrelatedJitter) Reset() {}
+
+// Constant always waits the same Delay between attempts.
+type Constant struct {
+	Delay time.Duration
+}
+
+func NewConstant(delay time.Duration) *Constant {
+	return &Constant{Delay: delay}
+}
+
+func (c *Constant) NextDelay(attempt int, lastDelay time.Duration) time.Duration {
+	return c.Delay
+}
+
+func (c *Constant) Reset() {}
@@ -0,0 +1,59 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponential(t *testing.T) {
+	e := NewExponential(100*time.Millisecond, 2)
+
+	assert.Equal(t, 100*time.Millisecond, e.NextDelay(0, 0))
+	assert.Equal(t, 200*time.Millisecond, e.NextDelay(1, 100*time.Millisecond))
+	assert.Equal(t, 400*time.Millisecond, e.NextDelay(2, 200*time.Millisecond))
+}
+
+func TestExponentialWithFullJitter(t *testing.T) {
+	e := NewExponentialWithFullJitter(100*time.Millisecond, 2)
+
+	for attempt := 0; attempt < 5; attempt++ {
+		delay := e.NextDelay(attempt, 0)
+		max := e.Exponential.NextDelay(attempt, 0)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, max)
+	}
+}
+
+func TestExponentialWithEqualJitter(t *testing.T) {
+	e := NewExponentialWithEqualJitter(100*time.Millisecond, 2)
+
+	for attempt := 0; attempt < 5; attempt++ {
+		delay := e.NextDelay(attempt, 0)
+		full := e.Exponential.NextDelay(attempt, 0)
+		assert.GreaterOrEqual(t, delay, full/2)
+		assert.LessOrEqual(t, delay, full)
+	}
+}
+
+func TestDecorrelatedJitter(t *testing.T) {
+	d := NewDecorrelatedJitter(100*time.Millisecond, time.Second)
+
+	delay := d.NextDelay(0, 0)
+	assert.GreaterOrEqual(t, delay, d.Base)
+	assert.LessOrEqual(t, delay, d.Cap)
+
+	for attempt := 1; attempt < 10; attempt++ {
+		delay = d.NextDelay(attempt, delay)
+		assert.GreaterOrEqual(t, delay, d.Base)
+		assert.LessOrEqual(t, delay, d.Cap)
+	}
+}
+
+func TestConstant(t *testing.T) {
+	c := NewConstant(250 * time.Millisecond)
+
+	assert.Equal(t, 250*time.Millisecond, c.NextDelay(0, 0))
+	assert.Equal(t, 250*time.Millisecond, c.NextDelay(7, 250*time.Millisecond))
+}
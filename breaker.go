@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	ErrFatal         = errors.New("breaker: fatal error")
-	ErrHitMaxRetries = errors.New("breaker: hit max retries")
+	ErrFatal             = errors.New("breaker: fatal error")
+	ErrHitMaxRetries     = errors.New("breaker: hit max retries")
+	ErrHitMaxElapsedTime = errors.New("breaker: hit max elapsed time")
 )
 
 type Breaker struct {
@@ -19,6 +20,20 @@ type Breaker struct {
 	backoff  time.Duration
 	factor   float64
 	maxTries int
+
+	strategy          BackoffStrategy
+	maxInterval       time.Duration
+	maxElapsedTime    time.Duration
+	successResetAfter time.Duration
+
+	ephemeralHandlers []*EphemeralErrorHandler
+
+	retryIf             func(error) bool
+	retryableHTTPStatus map[int]bool
+
+	onRetry   func(a Attempt)
+	onSuccess func(a Attempt)
+	onGiveUp  func(a Attempt)
 }
 
 // use *slog.Logger as logger
@@ -48,8 +63,16 @@ func New(log logger.Logger, backoff time.Duration, factor float64, maxTries int)
 // Do is an exponential-backoff-retry caller which will wait `backoff*factor**retry` up to `maxTries`
 // `maxTries = 1` means retry only once when an error occurs.
 func (b *Breaker) Do(ctx context.Context, fn func() error) error {
-	delay := float64(b.backoff)
+	strategy := b.strategy
+	if strategy == nil {
+		strategy = &Exponential{Base: b.backoff, Factor: b.factor}
+	}
+	strategy.Reset()
+
+	start := time.Now()
+	var delay time.Duration
 	try := 0
+	attempts := 0
 	for {
 		select {
 		case <-ctx.Done():
@@ -57,33 +80,77 @@ func (b *Breaker) Do(ctx context.Context, fn func() error) error {
 		default:
 		}
 
+		attemptStart := time.Now()
+		attempts++
 		err := fn()
 		if err == nil {
+			if b.onSuccess != nil {
+				b.onSuccess(Attempt{Number: attempts, Elapsed: time.Since(start)})
+			}
 			return nil
 		}
 
 		// If we failed for some reason, exp backoff and retry.
 
+		// Reset the retry budget if this attempt ran long enough to indicate
+		// real progress, rather than failing fast.
+		if b.successResetAfter > 0 && time.Since(attemptStart) >= b.successResetAfter {
+			strategy.Reset()
+			try = 0
+			delay = 0
+		}
+
 		// Check if is fatal error and should stop immediately
 		if errors.Is(err, ErrFatal) {
 			return err
 		}
 
+		// Check retryIf/WithRetryOnHTTPStatus classification and Permanent wrapping.
+		if retry, finalErr := b.shouldRetry(err); !retry {
+			if b.onGiveUp != nil {
+				b.onGiveUp(Attempt{Number: attempts, Err: finalErr, Elapsed: time.Since(start)})
+			}
+			return finalErr
+		}
+
 		// Move on if we have tried a few times.
 		if try >= b.maxTries {
-			if b.log != nil {
-				b.log.Errorf("breaker: exhausted after max number of retries maxTries=(%d)", b.maxTries)
+			b.logf(logger.LogLevel_ERROR, err, "breaker: exhausted after max number of retries maxTries=(%d)", b.maxTries)
+			if b.onGiveUp != nil {
+				b.onGiveUp(Attempt{Number: attempts, Err: err, Elapsed: time.Since(start)})
 			}
 			return superr.New(ErrHitMaxRetries, err)
 		}
 
-		if b.log != nil {
-			b.log.Warnf("breaker: fn failed, trying again backOffDelay=(%d), try=(%d), error=(%v)", time.Duration(int64(delay)).String(), try+1, err)
+		// Move on if we've been retrying for too long, regardless of maxTries.
+		if b.maxElapsedTime > 0 && time.Since(start) >= b.maxElapsedTime {
+			b.logf(logger.LogLevel_ERROR, err, "breaker: exhausted after max elapsed time maxElapsedTime=(%s)", b.maxElapsedTime)
+			if b.onGiveUp != nil {
+				b.onGiveUp(Attempt{Number: attempts, Err: err, Elapsed: time.Since(start)})
+			}
+			return superr.New(ErrHitMaxElapsedTime, err)
+		}
+
+		delay = strategy.NextDelay(try, delay)
+		if b.maxInterval > 0 && delay > b.maxInterval {
+			delay = b.maxInterval
+		}
+
+		// Honor a server-specified Retry-After, if the error carries one.
+		var retryAfterErr RetryAfterError
+		if errors.As(err, &retryAfterErr) {
+			if after := retryAfterErr.RetryAfter(); after > delay {
+				delay = after
+			}
+		}
+
+		b.logf(logger.LogLevel_WARN, err, "breaker: fn failed, trying again backOffDelay=(%s), try=(%d), error=(%v)", delay, try+1, err)
+		if b.onRetry != nil {
+			b.onRetry(Attempt{Number: attempts, Delay: delay, Err: err, Elapsed: time.Since(start)})
 		}
 
 		// Sleep and try again.
-		time.Sleep(time.Duration(int64(delay)))
-		delay *= b.factor
+		time.Sleep(delay)
 		try++
 	}
 }
@@ -92,3 +159,72 @@ func (b *Breaker) Do(ctx context.Context, fn func() error) error {
 func Do(ctx context.Context, fn func() error, log logger.Logger, backoff time.Duration, factor float64, maxTries int) error {
 	return New(log, backoff, factor, maxTries).Do(ctx, fn)
 }
+
+// WithBackoffStrategy overrides the default exponential backoff (backoff*factor**retry)
+// with the given BackoffStrategy, e.g. one of the jittered strategies in backoff.go.
+func (b *Breaker) WithBackoffStrategy(strategy BackoffStrategy) *Breaker {
+	b.strategy = strategy
+	return b
+}
+
+// WithMaxInterval caps the delay computed for any single attempt at d, regardless
+// of what the backoff strategy would otherwise return.
+func (b *Breaker) WithMaxInterval(d time.Duration) *Breaker {
+	b.maxInterval = d
+	return b
+}
+
+// WithMaxElapsedTime aborts retries once d has elapsed since the first call to fn,
+// regardless of maxTries. A zero value (the default) means no wall-clock limit.
+func (b *Breaker) WithMaxElapsedTime(d time.Duration) *Breaker {
+	b.maxElapsedTime = d
+	return b
+}
+
+// WithSuccessResetAfter resets the retry budget (try count and backoff delay)
+// whenever a single fn invocation runs for at least d before failing. That
+// duration suggests the attempt made real progress rather than failing fast,
+// so a long-lived worker (e.g. a stream consumer that reconnects after hours
+// of uptime) isn't permanently stuck at max-backoff, or tripped into
+// ErrHitMaxRetries, by transient blips spread far enough apart in time. A
+// zero value (the default) disables this behavior.
+func (b *Breaker) WithSuccessResetAfter(d time.Duration) *Breaker {
+	b.successResetAfter = d
+	return b
+}
+
+// WithEphemeralErrors registers handlers that downgrade the log level Breaker
+// uses for known-transient errors (mempool-full, rate-limit, DNS blip, ...)
+// so they don't escalate to Warn/Error unless they turn chronic. See
+// EphemeralErrorHandler.
+func (b *Breaker) WithEphemeralErrors(handlers ...*EphemeralErrorHandler) *Breaker {
+	b.ephemeralHandlers = append(b.ephemeralHandlers, handlers...)
+	return b
+}
+
+// logf logs at level, unless an ephemeral error handler downgrades it for err.
+func (b *Breaker) logf(level logger.Level, err error, format string, args ...interface{}) {
+	if b.log == nil {
+		return
+	}
+
+	fallback := level
+	matched := false
+	for _, h := range b.ephemeralHandlers {
+		lvl, ok := h.Observe(err, fallback)
+		if ok && !matched {
+			level, matched = lvl, true
+		}
+	}
+
+	switch level {
+	case logger.LogLevel_DEBUG:
+		b.log.Debugf(format, args...)
+	case logger.LogLevel_INFO:
+		b.log.Infof(format, args...)
+	case logger.LogLevel_WARN:
+		b.log.Warnf(format, args...)
+	default:
+		b.log.Errorf(format, args...)
+	}
+}
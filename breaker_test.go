@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goware/logger"
 	"github.com/goware/superr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,11 +66,31 @@ func (h *TestLogHandler) Reset() {
 	h.records = h.records[:0]
 }
 
+// slogLogger adapts a *slog.Logger to logger.Logger so tests can assert on
+// log levels via a custom slog.Handler instead of scraping formatted output.
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (s *slogLogger) With(args ...interface{}) logger.Logger {
+	return &slogLogger{l: s.l.With(args...)}
+}
+func (s *slogLogger) Debug(v ...interface{})                 { s.l.Debug(fmt.Sprint(v...)) }
+func (s *slogLogger) Debugf(format string, v ...interface{}) { s.l.Debug(fmt.Sprintf(format, v...)) }
+func (s *slogLogger) Info(v ...interface{})                  { s.l.Info(fmt.Sprint(v...)) }
+func (s *slogLogger) Infof(format string, v ...interface{})  { s.l.Info(fmt.Sprintf(format, v...)) }
+func (s *slogLogger) Warn(v ...interface{})                  { s.l.Warn(fmt.Sprint(v...)) }
+func (s *slogLogger) Warnf(format string, v ...interface{})  { s.l.Warn(fmt.Sprintf(format, v...)) }
+func (s *slogLogger) Error(v ...interface{})                 { s.l.Error(fmt.Sprint(v...)) }
+func (s *slogLogger) Errorf(format string, v ...interface{}) { s.l.Error(fmt.Sprintf(format, v...)) }
+func (s *slogLogger) Fatal(v ...interface{})                 { s.l.Error(fmt.Sprint(v...)) }
+func (s *slogLogger) Fatalf(format string, v ...interface{}) { s.l.Error(fmt.Sprintf(format, v...)) }
+
 func TestBreakerDo(t *testing.T) {
 	handler := NewTestLogHandler()
-	logger := slog.New(handler)
+	log := &slogLogger{l: slog.New(handler)}
 
-	br := New(logger, 100*time.Millisecond, 2, 3)
+	br := New(log, 100*time.Millisecond, 2, 3)
 
 	t.Run("FailsEachTime", func(t *testing.T) {
 		handler.Reset()
@@ -172,4 +193,87 @@ func TestBreakerDo(t *testing.T) {
 		require.Error(t, err)
 		assert.True(t, errors.Is(err, ErrHitMaxRetries))
 	})
+
+	t.Run("WithMaxInterval", func(t *testing.T) {
+		brCapped := New(nil, 100*time.Millisecond, 10, 3).WithMaxInterval(150 * time.Millisecond)
+
+		start := time.Now()
+		err := brCapped.Do(context.Background(), func() error {
+			return fmt.Errorf("error")
+		})
+		elapsed := time.Since(start)
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrHitMaxRetries))
+		// Uncapped this would be 100ms + 1000ms + 10000ms; capped at 150ms per try it should be well under 1s.
+		assert.Less(t, elapsed, 1*time.Second)
+	})
+
+	t.Run("WithMaxElapsedTime", func(t *testing.T) {
+		brTimeboxed := New(nil, 50*time.Millisecond, 2, 100).WithMaxElapsedTime(120 * time.Millisecond)
+
+		err := brTimeboxed.Do(context.Background(), func() error {
+			return fmt.Errorf("error")
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrHitMaxElapsedTime))
+	})
+
+	t.Run("WithBackoffStrategy", func(t *testing.T) {
+		brConstant := New(nil, 100*time.Millisecond, 2, 3).WithBackoffStrategy(NewConstant(10 * time.Millisecond))
+
+		start := time.Now()
+		err := brConstant.Do(context.Background(), func() error {
+			return fmt.Errorf("error")
+		})
+		elapsed := time.Since(start)
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrHitMaxRetries))
+		assert.Less(t, elapsed, 100*time.Millisecond)
+	})
+
+	t.Run("WithEphemeralErrors", func(t *testing.T) {
+		handler.Reset()
+
+		brEphemeral := New(log, 10*time.Millisecond, 1, 3).
+			WithEphemeralErrors(NewEphemeralErrorHandler(time.Minute, "mempool full", time.Hour))
+
+		err := brEphemeral.Do(context.Background(), func() error {
+			return fmt.Errorf("mempool full, try again")
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrHitMaxRetries))
+
+		// The mempool-full error is fresh, so it should be logged at Debug
+		// instead of Warn/Error, and not trip the usual level counts.
+		assert.Equal(t, 0, handler.CountLevel(slog.LevelWarn))
+		assert.Equal(t, 0, handler.CountLevel(slog.LevelError))
+		assert.Equal(t, 4, handler.CountLevel(slog.LevelDebug))
+	})
+
+	t.Run("WithMultipleEphemeralHandlers", func(t *testing.T) {
+		handler.Reset()
+
+		// Neither handler matches the other's substring, so each must be
+		// consulted with the true Warn/Error fallback, not whatever the
+		// previous handler in the list left behind.
+		brEphemeral := New(log, 10*time.Millisecond, 1, 3).
+			WithEphemeralErrors(
+				NewEphemeralErrorHandler(time.Minute, "connection reset", time.Hour),
+				NewEphemeralErrorHandler(time.Minute, "mempool full", time.Hour),
+			)
+
+		err := brEphemeral.Do(context.Background(), func() error {
+			return fmt.Errorf("mempool full, try again")
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrHitMaxRetries))
+
+		// The second handler is the one that matches, and should still
+		// downgrade to Debug even though the first handler passed on it.
+		assert.Equal(t, 0, handler.CountLevel(slog.LevelWarn))
+		assert.Equal(t, 0, handler.CountLevel(slog.LevelError))
+		assert.Equal(t, 4, handler.CountLevel(slog.LevelDebug))
+	})
 }
@@ -0,0 +1,84 @@
+package breaker
+
+import (
+	"errors"
+	"time"
+)
+
+// PermanentError wraps an error to tell Breaker to stop retrying immediately
+// and return the wrapped error unmodified, regardless of WithRetryIf or
+// maxTries. Construct one with Permanent.
+type PermanentError struct {
+	Err error
+}
+
+// Permanent wraps err so Breaker treats it as non-retryable. A nil err
+// returns nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+func (e *PermanentError) Error() string { return e.Err.Error() }
+func (e *PermanentError) Unwrap() error { return e.Err }
+
+// HTTPStatusError is implemented by errors that carry the HTTP status code
+// of the response that produced them, so WithRetryOnHTTPStatus can decide
+// whether to retry based on it.
+type HTTPStatusError interface {
+	error
+	StatusCode() int
+}
+
+// RetryAfterError is implemented by errors that know how long the caller
+// should wait before retrying (e.g. parsed from a Retry-After header).
+// Breaker sleeps for max(backoff, RetryAfter()) when it sees one.
+type RetryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+// WithRetryIf registers a predicate that decides whether an error is worth
+// retrying at all. Returning false stops the retry loop immediately and
+// returns the error as-is, the same as wrapping it with Permanent.
+func (b *Breaker) WithRetryIf(retryIf func(error) bool) *Breaker {
+	b.retryIf = retryIf
+	return b
+}
+
+// WithRetryOnHTTPStatus restricts retries to errors whose HTTPStatusError
+// status code is in codes. An error that doesn't implement HTTPStatusError
+// is unaffected by this option. Typically used to retry 5xx/429 but not 4xx.
+func (b *Breaker) WithRetryOnHTTPStatus(codes ...int) *Breaker {
+	if b.retryableHTTPStatus == nil {
+		b.retryableHTTPStatus = make(map[int]bool, len(codes))
+	}
+	for _, code := range codes {
+		b.retryableHTTPStatus[code] = true
+	}
+	return b
+}
+
+// shouldRetry reports whether err should trigger another attempt. When it
+// returns false, finalErr is what Breaker.Do should return.
+func (b *Breaker) shouldRetry(err error) (retry bool, finalErr error) {
+	var perm *PermanentError
+	if errors.As(err, &perm) {
+		return false, perm.Err
+	}
+
+	if b.retryIf != nil && !b.retryIf(err) {
+		return false, err
+	}
+
+	if len(b.retryableHTTPStatus) > 0 {
+		var statusErr HTTPStatusError
+		if errors.As(err, &statusErr) && !b.retryableHTTPStatus[statusErr.StatusCode()] {
+			return false, err
+		}
+	}
+
+	return true, err
+}
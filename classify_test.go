@@ -0,0 +1,130 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string   { return fmt.Sprintf("status %d", e.code) }
+func (e *statusError) StatusCode() int { return e.code }
+
+type retryAfterError struct {
+	after time.Duration
+}
+
+func (e *retryAfterError) Error() string             { return "rate limited" }
+func (e *retryAfterError) RetryAfter() time.Duration { return e.after }
+
+func TestPermanent(t *testing.T) {
+	assert.Nil(t, Permanent(nil))
+
+	err := errors.New("boom")
+	perm := Permanent(err)
+	require.Error(t, perm)
+	assert.Equal(t, "boom", perm.Error())
+	assert.True(t, errors.Is(perm, err))
+
+	var permErr *PermanentError
+	require.True(t, errors.As(perm, &permErr))
+	assert.Same(t, err, permErr.Unwrap())
+}
+
+func TestBreakerWithRetryIf(t *testing.T) {
+	var tries int
+
+	err := New(nil, time.Millisecond, 1, 5).
+		WithRetryIf(func(err error) bool { return err.Error() != "stop" }).
+		Do(context.Background(), func() error {
+			tries++
+			if tries == 2 {
+				return errors.New("stop")
+			}
+			return errors.New("keep going")
+		})
+
+	require.Error(t, err)
+	assert.Equal(t, "stop", err.Error())
+	assert.Equal(t, 2, tries)
+}
+
+func TestBreakerWithPermanent(t *testing.T) {
+	var tries int
+	var giveUp Attempt
+
+	err := New(nil, time.Millisecond, 1, 5).
+		WithOnGiveUp(func(a Attempt) {
+			giveUp = a
+		}).
+		Do(context.Background(), func() error {
+			tries++
+			if tries == 2 {
+				return Permanent(errors.New("bad request"))
+			}
+			return errors.New("keep going")
+		})
+
+	require.Error(t, err)
+	assert.Equal(t, "bad request", err.Error())
+	assert.Equal(t, 2, tries)
+
+	// A Permanent error is a give-up, not just a regular return, so
+	// OnGiveUp must fire for it the same as hitting maxTries.
+	require.Error(t, giveUp.Err)
+	assert.Equal(t, "bad request", giveUp.Err.Error())
+	assert.Equal(t, 2, giveUp.Number)
+}
+
+func TestBreakerWithRetryOnHTTPStatus(t *testing.T) {
+	t.Run("RetriesMatchingStatus", func(t *testing.T) {
+		var tries int
+
+		err := New(nil, time.Millisecond, 1, 3).
+			WithRetryOnHTTPStatus(429, 500, 503).
+			Do(context.Background(), func() error {
+				tries++
+				return &statusError{code: 503}
+			})
+
+		require.Error(t, err)
+		assert.Equal(t, 4, tries) // initial attempt + 3 retries, then gives up
+	})
+
+	t.Run("StopsOnUnlistedStatus", func(t *testing.T) {
+		var tries int
+
+		err := New(nil, time.Millisecond, 1, 3).
+			WithRetryOnHTTPStatus(429, 500, 503).
+			Do(context.Background(), func() error {
+				tries++
+				return &statusError{code: 404}
+			})
+
+		require.Error(t, err)
+		assert.Equal(t, 1, tries)
+		var statusErr HTTPStatusError
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, 404, statusErr.StatusCode())
+	})
+}
+
+func TestBreakerHonorsRetryAfter(t *testing.T) {
+	start := time.Now()
+
+	err := New(nil, time.Millisecond, 1, 1).
+		Do(context.Background(), func() error {
+			return &retryAfterError{after: 50 * time.Millisecond}
+		})
+
+	require.Error(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
@@ -0,0 +1,20 @@
+package breaker
+
+import "context"
+
+// DoWithData retries fn under b's policy like Do, but returns the value fn
+// produces on success instead of requiring callers to close over a result
+// variable (e.g. an HTTP body, a DB row, an RPC response).
+//
+// Go doesn't allow type parameters on methods, so this can't be a method on
+// Breaker; b is passed explicitly instead, the same way the package-level Do
+// takes a Breaker's settings directly.
+func DoWithData[T any](ctx context.Context, b *Breaker, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := b.Do(ctx, func() error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
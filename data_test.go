@@ -0,0 +1,40 @@
+package breaker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoWithData(t *testing.T) {
+	t.Run("ReturnsValueOnSuccess", func(t *testing.T) {
+		br := New(nil, 10*time.Millisecond, 1, 3)
+
+		count := 0
+		result, err := DoWithData(context.Background(), br, func(ctx context.Context) (string, error) {
+			defer func() { count++ }()
+			if count == 0 {
+				return "", fmt.Errorf("boom")
+			}
+			return "ok", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", result)
+	})
+
+	t.Run("ReturnsLastValueOnGiveUp", func(t *testing.T) {
+		br := New(nil, 10*time.Millisecond, 1, 1)
+
+		result, err := DoWithData(context.Background(), br, func(ctx context.Context) (int, error) {
+			return 42, fmt.Errorf("boom")
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, 42, result)
+	})
+}
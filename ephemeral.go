@@ -0,0 +1,80 @@
+package breaker
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goware/logger"
+)
+
+// EphemeralErrorHandler recognizes a known-transient error and tells Breaker
+// to downgrade the log level it would normally use for it (Warn on retry,
+// Error on giving up) to Debug, then Info, for as long as the error has been
+// occurring for less than Duration. Once the error has persisted for at
+// least Duration, the handler stops downgrading it and Breaker logs at its
+// usual level again, so a condition that was expected to be a blip but
+// turned chronic doesn't go silent.
+//
+// Construct one with NewEphemeralErrorHandler and pass it to
+// Breaker.WithEphemeralErrors. An EphemeralErrorHandler is safe for
+// concurrent use.
+type EphemeralErrorHandler struct {
+	duration          time.Duration
+	logLevelThreshold time.Duration
+	match             func(error) bool
+
+	mu        sync.Mutex
+	firstSeen time.Time
+}
+
+// NewEphemeralErrorHandler matches errors whose Error() contains substr.
+// While such an error keeps occurring, it is logged at Debug for the first
+// logLevelThreshold, then at Info until duration has elapsed, after which
+// Breaker escalates back to its normal Warn/Error level. Any error that
+// doesn't match resets the handler, so an intervening unrelated failure
+// doesn't keep this one perpetually downgraded.
+func NewEphemeralErrorHandler(duration time.Duration, substr string, logLevelThreshold time.Duration) *EphemeralErrorHandler {
+	return &EphemeralErrorHandler{
+		duration:          duration,
+		logLevelThreshold: logLevelThreshold,
+		match: func(err error) bool {
+			return err != nil && strings.Contains(err.Error(), substr)
+		},
+	}
+}
+
+// WithMatchFunc overrides the substring match set up by NewEphemeralErrorHandler
+// with a custom predicate.
+func (h *EphemeralErrorHandler) WithMatchFunc(match func(error) bool) *EphemeralErrorHandler {
+	h.match = match
+	return h
+}
+
+// Observe records err and returns the log level Breaker should use for it.
+// matched reports whether err matched this handler at all; when it didn't,
+// level is always fallback and the handler's internal timer is reset.
+func (h *EphemeralErrorHandler) Observe(err error, fallback logger.Level) (level logger.Level, matched bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.match(err) {
+		h.firstSeen = time.Time{}
+		return fallback, false
+	}
+
+	if h.firstSeen.IsZero() {
+		h.firstSeen = time.Now()
+	}
+
+	age := time.Since(h.firstSeen)
+	switch {
+	case h.duration > 0 && age >= h.duration:
+		// Persisted too long; stop suppressing and escalate back.
+		return fallback, true
+	case h.logLevelThreshold > 0 && age >= h.logLevelThreshold:
+		return logger.LogLevel_INFO, true
+	default:
+		return logger.LogLevel_DEBUG, true
+	}
+}
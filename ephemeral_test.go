@@ -0,0 +1,58 @@
+package breaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goware/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralErrorHandler(t *testing.T) {
+	t.Run("NoMatchFallsBackAndResets", func(t *testing.T) {
+		h := NewEphemeralErrorHandler(time.Minute, "mempool full", time.Second)
+
+		_, matched := h.Observe(errors.New("mempool full"), logger.LogLevel_WARN)
+		assert.True(t, matched)
+		assert.False(t, h.firstSeen.IsZero())
+
+		level, matched := h.Observe(errors.New("connection refused"), logger.LogLevel_WARN)
+		assert.False(t, matched)
+		assert.Equal(t, logger.LogLevel_WARN, level)
+		assert.True(t, h.firstSeen.IsZero())
+	})
+
+	t.Run("DowngradesFreshMatchToDebug", func(t *testing.T) {
+		h := NewEphemeralErrorHandler(time.Minute, "mempool full", time.Hour)
+
+		level, matched := h.Observe(errors.New("mempool full, try again"), logger.LogLevel_WARN)
+		assert.True(t, matched)
+		assert.Equal(t, logger.LogLevel_DEBUG, level)
+	})
+
+	t.Run("EscalatesBackAfterDuration", func(t *testing.T) {
+		h := NewEphemeralErrorHandler(10*time.Millisecond, "mempool full", time.Millisecond)
+
+		level, matched := h.Observe(errors.New("mempool full"), logger.LogLevel_WARN)
+		assert.True(t, matched)
+		assert.Equal(t, logger.LogLevel_DEBUG, level)
+
+		time.Sleep(20 * time.Millisecond)
+
+		level, matched = h.Observe(errors.New("mempool full"), logger.LogLevel_WARN)
+		assert.True(t, matched)
+		assert.Equal(t, logger.LogLevel_WARN, level)
+	})
+
+	t.Run("WithMatchFunc", func(t *testing.T) {
+		h := NewEphemeralErrorHandler(time.Minute, "unused", time.Hour).
+			WithMatchFunc(func(err error) bool { return errors.Is(err, errRateLimited) })
+
+		level, matched := h.Observe(errRateLimited, logger.LogLevel_WARN)
+		assert.True(t, matched)
+		assert.Equal(t, logger.LogLevel_DEBUG, level)
+	})
+}
+
+var errRateLimited = errors.New("rate limited")
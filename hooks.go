@@ -0,0 +1,43 @@
+package breaker
+
+import "time"
+
+// Attempt carries the information Breaker has about a single Do call when
+// it invokes the OnRetry/OnSuccess/OnGiveUp hooks, so integrations (metrics,
+// tracing, custom logging) don't have to reconstruct attempt counts or
+// timing themselves.
+type Attempt struct {
+	Number  int           // 1-indexed count of attempts made so far
+	Delay   time.Duration // delay before the next attempt; zero for OnSuccess/OnGiveUp
+	Err     error         // error from the most recent attempt; nil for OnSuccess
+	Elapsed time.Duration // time since Do was first called
+}
+
+// WithOnRetry registers a hook called after each failed attempt that will be
+// retried, with an Attempt describing the attempt number, the delay before
+// the next attempt, and the error that caused the retry. Useful for
+// emitting metrics like retry_attempts_total or pushing tracing spans.
+func (b *Breaker) WithOnRetry(fn func(a Attempt)) *Breaker {
+	b.onRetry = fn
+	return b
+}
+
+// WithOnSuccess registers a hook called once fn succeeds, with an Attempt
+// describing the total number of attempts made and the time elapsed since
+// the first attempt. Delay and Err are always zero/nil.
+func (b *Breaker) WithOnSuccess(fn func(a Attempt)) *Breaker {
+	b.onSuccess = fn
+	return b
+}
+
+// WithOnGiveUp registers a hook called whenever Breaker stops retrying
+// without succeeding, with an Attempt describing the total number of
+// attempts made and the final error. Delay is always zero. This covers
+// hitting maxTries or maxElapsedTime as well as a classified non-retryable
+// error (Permanent, WithRetryIf returning false, or an unlisted HTTP
+// status via WithRetryOnHTTPStatus). It is not called when fn returns an
+// ErrFatal error or when ctx is done; Do returns directly in those cases.
+func (b *Breaker) WithOnGiveUp(fn func(a Attempt)) *Breaker {
+	b.onGiveUp = fn
+	return b
+}
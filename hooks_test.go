@@ -0,0 +1,69 @@
+package breaker
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreakerHooks(t *testing.T) {
+	t.Run("OnRetryAndOnGiveUp", func(t *testing.T) {
+		var retries int32
+		var lastRetry Attempt
+		var giveUpAttempts int
+		var giveUpErr error
+
+		br := New(nil, 10*time.Millisecond, 1, 2).
+			WithOnRetry(func(a Attempt) {
+				atomic.AddInt32(&retries, 1)
+				lastRetry = a
+			}).
+			WithOnGiveUp(func(a Attempt) {
+				giveUpAttempts = a.Number
+				giveUpErr = a.Err
+			})
+
+		err := br.Do(context.Background(), func() error {
+			return fmt.Errorf("boom")
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&retries))
+		assert.Equal(t, 2, lastRetry.Number)
+		assert.Equal(t, 10*time.Millisecond, lastRetry.Delay)
+		require.Error(t, lastRetry.Err)
+		assert.Equal(t, "boom", lastRetry.Err.Error())
+		assert.Equal(t, 3, giveUpAttempts)
+		require.Error(t, giveUpErr)
+		assert.Equal(t, "boom", giveUpErr.Error())
+	})
+
+	t.Run("OnSuccess", func(t *testing.T) {
+		var successAttempts int
+		var successElapsed time.Duration
+
+		count := 0
+		br := New(nil, 10*time.Millisecond, 1, 3).
+			WithOnSuccess(func(a Attempt) {
+				successAttempts = a.Number
+				successElapsed = a.Elapsed
+			})
+
+		err := br.Do(context.Background(), func() error {
+			defer func() { count++ }()
+			if count == 0 {
+				return fmt.Errorf("boom")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, successAttempts)
+		assert.GreaterOrEqual(t, successElapsed, 10*time.Millisecond)
+	})
+}
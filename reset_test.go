@@ -0,0 +1,64 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreakerWithSuccessResetAfter(t *testing.T) {
+	t.Run("FastFailDoesNotReset", func(t *testing.T) {
+		var retries []time.Duration
+
+		err := New(nil, 5*time.Millisecond, 2, 3).
+			WithSuccessResetAfter(50*time.Millisecond).
+			WithOnRetry(func(a Attempt) {
+				retries = append(retries, a.Delay)
+			}).
+			Do(context.Background(), func() error {
+				return errors.New("boom")
+			})
+
+		require.Error(t, err)
+		// Every attempt fails well under the reset threshold, so the backoff
+		// keeps doubling instead of resetting back to the base delay.
+		require.Len(t, retries, 3)
+		assert.Equal(t, retries[0], 5*time.Millisecond)
+		assert.Equal(t, retries[1], 10*time.Millisecond)
+		assert.Equal(t, retries[2], 20*time.Millisecond)
+	})
+
+	t.Run("SlowFailResetsBudget", func(t *testing.T) {
+		var tries int
+		var successAttempt Attempt
+
+		err := New(nil, 5*time.Millisecond, 1, 3).
+			WithSuccessResetAfter(20*time.Millisecond).
+			WithOnSuccess(func(a Attempt) {
+				successAttempt = a
+			}).
+			Do(context.Background(), func() error {
+				tries++
+				if tries <= 2 {
+					// Long-running attempt that ultimately fails; should
+					// reset the retry budget instead of counting toward it.
+					time.Sleep(30 * time.Millisecond)
+				}
+				if tries == 5 {
+					return nil
+				}
+				return errors.New("boom")
+			})
+
+		// Without the reset, maxTries=3 would exhaust before the 5th attempt.
+		require.NoError(t, err)
+		assert.Equal(t, 5, tries)
+		// Attempt.Number must count every real call fn made, not the
+		// backoff try counter, which was reset twice along the way.
+		assert.Equal(t, 5, successAttempt.Number)
+	})
+}
@@ -3,6 +3,7 @@ package breaker
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/goware/superr"
@@ -37,13 +38,13 @@ func ExpBackoffRetry(ctx context.Context, fn func(ctx context.Context) error, lo
 		}
 
 		if log != nil {
-			log.Warnf("breaker: fn failed: '%v' - backing off for %v and trying again (retry #%d)", err, time.Duration(int64(delay)).String(), try+1)
+			log.Warn(fmt.Sprintf("breaker: fn failed: '%v' - backing off for %v and trying again (retry #%d)", err, time.Duration(int64(delay)).String(), try+1))
 		}
 
 		// Move on if we have tried a few times.
 		if try >= maxTries {
 			if log != nil {
-				log.Errorf("breaker: exhausted after max number of retries %d. fail :(", maxTries)
+				log.Error(fmt.Sprintf("breaker: exhausted after max number of retries %d. fail :(", maxTries))
 			}
 			return superr.New(ErrExhaustedRetries, err)
 		}
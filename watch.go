@@ -0,0 +1,83 @@
+package breaker
+
+import (
+	"context"
+	"reflect"
+)
+
+// Watch binds a channel to a handler that DoWithWatches runs (and retries,
+// under the Breaker's own backoff policy) whenever a value arrives on the
+// channel. Construct one with NewWatch.
+type Watch struct {
+	ch      reflect.Value
+	handler func(ctx context.Context, v interface{}) error
+}
+
+// NewWatch binds ch to handler. The generic type parameter is erased so that
+// watches over channels of different types can be passed to DoWithWatches
+// together.
+func NewWatch[T any](ch <-chan T, handler func(ctx context.Context, v T) error) Watch {
+	return Watch{
+		ch: reflect.ValueOf(ch),
+		handler: func(ctx context.Context, v interface{}) error {
+			return handler(ctx, v.(T))
+		},
+	}
+}
+
+// DoWithWatches runs fn under the Breaker's retry policy, like Do, while also
+// multiplexing any number of watch channels via reflect.Select: each time a
+// value arrives on a watch's channel, its handler is invoked and retried
+// independently under the same backoff policy, while fn continues its own
+// retry cycle undisturbed. This lets a single Breaker drive a long-running
+// reconciliation loop (fn) that also reacts to config-change or invalidation
+// signals (watches).
+//
+// DoWithWatches returns what Do returns for fn. When ctx is done first, it
+// still waits for that Do call to return, which happens only once fn itself
+// returns and Do sees ctx at the start of its next attempt.
+func (b *Breaker) DoWithWatches(ctx context.Context, fn func(ctx context.Context) error, watches ...Watch) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fnDone := make(chan error, 1)
+	go func() {
+		fnDone <- b.Do(ctx, func() error { return fn(ctx) })
+	}()
+
+	cases := make([]reflect.SelectCase, 0, len(watches)+2)
+	cases = append(cases,
+		reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(fnDone)},
+	)
+	for _, w := range watches {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: w.ch})
+	}
+
+	for {
+		chosen, recv, ok := reflect.Select(cases)
+
+		switch chosen {
+		case 0: // ctx.Done()
+			return <-fnDone
+		case 1: // fnDone
+			result, _ := recv.Interface().(error)
+			return result
+		}
+
+		w := watches[chosen-2]
+		if !ok {
+			// The watch channel was closed; stop selecting on it.
+			cases = append(cases[:chosen], cases[chosen+1:]...)
+			watches = append(watches[:chosen-2], watches[chosen-1:]...)
+			continue
+		}
+
+		value := recv.Interface()
+		go func() {
+			if err := b.Do(ctx, func() error { return w.handler(ctx, value) }); err != nil && b.log != nil {
+				b.log.Errorf("breaker: watch handler gave up: %v", err)
+			}
+		}()
+	}
+}
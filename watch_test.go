@@ -0,0 +1,52 @@
+package breaker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoWithWatches(t *testing.T) {
+	t.Run("HandlesWatchValuesWhileFnRuns", func(t *testing.T) {
+		br := New(nil, 10*time.Millisecond, 2, 5)
+
+		ch := make(chan int)
+		var handled int32
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			ch <- 1
+			ch <- 2
+		}()
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+
+		err := br.DoWithWatches(ctx, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, NewWatch(ch, func(ctx context.Context, v int) error {
+			atomic.AddInt32(&handled, 1)
+			return nil
+		}))
+
+		require.Error(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&handled))
+	})
+
+	t.Run("ReturnsWhenFnSucceeds", func(t *testing.T) {
+		br := New(nil, 10*time.Millisecond, 2, 5)
+
+		err := br.DoWithWatches(context.Background(), func(ctx context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+	})
+}